@@ -0,0 +1,73 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope="Cluster"
+
+// ResourceInterpreterCustomization represents the configuration of a specific resource for
+// Karmada to get the structure of the resource customized, cluster-scoped.
+type ResourceInterpreterCustomization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec describes the configuration in detail.
+	Spec ResourceInterpreterCustomizationSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceInterpreterCustomizationList contains a list of ResourceInterpreterCustomization.
+type ResourceInterpreterCustomizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ResourceInterpreterCustomization `json:"items"`
+}
+
+// ResourceInterpreterCustomizationSpec describes the configuration of a
+// ResourceInterpreterCustomization, i.e. the rules that customize how Karmada interprets a
+// given target resource kind.
+type ResourceInterpreterCustomizationSpec struct {
+	// Target is the resource kind that this customization applies to.
+	Target CustomizationTarget `json:"target"`
+
+	// Customizations holds the interpreter rules for Target.
+	Customizations CustomizationRules `json:"customizations"`
+}
+
+// CustomizationTarget represents the GVK of the resource a ResourceInterpreterCustomization
+// applies to.
+type CustomizationTarget struct {
+	// APIVersion represents the API version of the target resource.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind represents the Kind of the target resource.
+	Kind string `json:"kind"`
+}
+
+// CustomizationRules holds the per-capability interpreter customizations for a target kind.
+type CustomizationRules struct {
+	// DependencyInterpretation customizes the dependency discovery for the target resource.
+	// +optional
+	DependencyInterpretation *DependencyInterpretation `json:"dependencyInterpretation,omitempty"`
+}
+
+// DependencyInterpretation tells Karmada how to discover a resource's DependentObjectReferences,
+// either by running LuaScript against the object, or by evaluating Template's declarative
+// rules. If both are set, LuaScript takes precedence.
+type DependencyInterpretation struct {
+	// LuaScript holds the Lua script that is used to interpret the dependencies of a
+	// specific resource.
+	// +optional
+	LuaScript string `json:"luaScript,omitempty"`
+
+	// Template declares dependency discovery as a list of field-selector rules instead of
+	// a Lua script, see DependencyExtractionTemplate.
+	// +optional
+	Template *DependencyExtractionTemplate `json:"template,omitempty"`
+}
@@ -0,0 +1,37 @@
+package v1alpha1
+
+// DependencyExtractionTemplate declares a list of declarative rules used to discover
+// DependentObjectReferences for a resource, as an alternative to a Lua
+// `dependenciesInterpreter` customization.
+//
+// It is set as DependencyInterpretation.Template in a ResourceInterpreterCustomization's
+// spec.customizations, so that onboarding a new CRD's dependencies only requires authoring
+// data, not a Lua script or a Go handler in the default interpreter.
+type DependencyExtractionTemplate struct {
+	// Rules is the list of field-selector rules evaluated, in order, against the resource.
+	// Rules are independent: every rule that finds a value contributes dependencies, and
+	// results are deduped by (APIVersion, Kind, Namespace, Name) across all rules.
+	// +optional
+	Rules []DependencyExtractionRule `json:"rules,omitempty"`
+}
+
+// DependencyExtractionRule describes how to locate a dependent object reference within a
+// resource using a JSONPath-like field selector.
+type DependencyExtractionRule struct {
+	// Path is the field path to the dependency's name, expressed as dot-separated field
+	// names. A `[*]` segment expands over all elements of a slice found at that point,
+	// evaluating the remainder of the path against each element.
+	// Example: "spec.template.spec.volumes[*].secret.secretName".
+	Path string `json:"path"`
+
+	// Kind is the Kind of the referenced dependent object, e.g. "Secret".
+	Kind string `json:"kind"`
+
+	// APIVersion is the APIVersion of the referenced dependent object, e.g. "v1".
+	APIVersion string `json:"apiVersion"`
+
+	// NamespaceFrom is the field path used to resolve the namespace of the referenced
+	// object. If empty, the namespace of the resource being interpreted is used.
+	// +optional
+	NamespaceFrom string `json:"namespaceFrom,omitempty"`
+}
@@ -7,8 +7,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -20,13 +22,31 @@ import (
 
 type dependenciesInterpreter func(cl client.Client, object *unstructured.Unstructured) ([]configv1alpha1.DependentObjectReference, error)
 
-func getAllDefaultDependenciesInterpreter() map[schema.GroupVersionKind]dependenciesInterpreter {
+// getAllDefaultDependenciesInterpreter returns the built-in, per-GVK dependenciesInterpreter
+// table, plus one compiled from each customization's DependencyExtractionTemplate, so that
+// onboarding a new CRD's dependency discovery doesn't require a rebuild of Karmada. A
+// template-backed interpreter takes precedence over a built-in one for the same GVK.
+func getAllDefaultDependenciesInterpreter(customizations []configv1alpha1.ResourceInterpreterCustomization) map[schema.GroupVersionKind]dependenciesInterpreter {
 	s := make(map[schema.GroupVersionKind]dependenciesInterpreter)
 	s[appsv1.SchemeGroupVersion.WithKind(util.DeploymentKind)] = getDeploymentDependencies
 	s[batchv1.SchemeGroupVersion.WithKind(util.JobKind)] = getJobDependencies
 	s[corev1.SchemeGroupVersion.WithKind(util.PodKind)] = getPodDependencies
 	s[appsv1.SchemeGroupVersion.WithKind(util.DaemonSetKind)] = getDaemonSetDependencies
 	s[appsv1.SchemeGroupVersion.WithKind(util.StatefulSetKind)] = getStatefulSetDependencies
+	s[batchv1.SchemeGroupVersion.WithKind(util.CronJobKind)] = getCronJobDependencies
+	s[appsv1.SchemeGroupVersion.WithKind(util.ReplicaSetKind)] = getReplicaSetDependencies
+	s[corev1.SchemeGroupVersion.WithKind(util.ReplicationControllerKind)] = getReplicationControllerDependencies
+
+	for _, customization := range customizations {
+		template := customization.Spec.Customizations.DependencyInterpretation
+		if template == nil || template.Template == nil {
+			continue
+		}
+
+		gvk := schema.FromAPIVersionAndKind(customization.Spec.Target.APIVersion, customization.Spec.Target.Kind)
+		s[gvk] = compileDependencyExtractionTemplate(template.Template)
+	}
+
 	return s
 }
 
@@ -95,9 +115,101 @@ func getStatefulSetDependencies(cl client.Client, object *unstructured.Unstructu
 	return getDependenciesFromPodTemplate(cl, podObj)
 }
 
+func getCronJobDependencies(cl client.Client, object *unstructured.Unstructured) ([]configv1alpha1.DependentObjectReference, error) {
+	cronJobObj, err := helper.ConvertToCronJob(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert CronJob from unstructured object: %v", err)
+	}
+
+	podObj, err := GetPodFromTemplate(&cronJobObj.Spec.JobTemplate.Spec.Template, cronJobObj, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return getDependenciesFromPodTemplate(cl, podObj)
+}
+
+func getReplicaSetDependencies(cl client.Client, object *unstructured.Unstructured) ([]configv1alpha1.DependentObjectReference, error) {
+	replicaSetObj, err := helper.ConvertToReplicaSet(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert ReplicaSet from unstructured object: %v", err)
+	}
+
+	podObj, err := GetPodFromTemplate(&replicaSetObj.Spec.Template, replicaSetObj, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return getDependenciesFromPodTemplate(cl, podObj)
+}
+
+func getReplicationControllerDependencies(cl client.Client, object *unstructured.Unstructured) ([]configv1alpha1.DependentObjectReference, error) {
+	rcObj, err := helper.ConvertToReplicationController(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert ReplicationController from unstructured object: %v", err)
+	}
+
+	if rcObj.Spec.Template == nil {
+		return nil, nil
+	}
+
+	podObj, err := GetPodFromTemplate(rcObj.Spec.Template, rcObj, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return getDependenciesFromPodTemplate(cl, podObj)
+}
+
+// podTemplatePaths are the well-known field paths, in priority order, under which an
+// arbitrary (e.g. custom/aggregated) resource may embed a PodTemplateSpec.
+var podTemplatePaths = [][]string{
+	{"spec", "template"},
+	{"spec", "jobTemplate", "spec", "template"},
+}
+
+// lookupDependenciesInterpreter returns the dependenciesInterpreter registered for gvk, or
+// getUnstructuredDependencies when gvk has no dedicated handler. Callers resolving a
+// dependenciesInterpreter for an object's GVK should go through this helper instead of
+// indexing interpreters directly, so that kinds with no built-in handler still get a best
+// effort answer from the generic PodTemplateSpec fallback.
+func lookupDependenciesInterpreter(interpreters map[schema.GroupVersionKind]dependenciesInterpreter, gvk schema.GroupVersionKind) dependenciesInterpreter {
+	if interpreter, ok := interpreters[gvk]; ok {
+		return interpreter
+	}
+	return getUnstructuredDependencies
+}
+
+// getUnstructuredDependencies is a fallback dependenciesInterpreter for kinds that embed a
+// PodTemplateSpec at one of the well-known podTemplatePaths but have no dedicated handler
+// registered above, e.g. custom workload CRDs that mirror the Deployment/CronJob shape.
+func getUnstructuredDependencies(cl client.Client, object *unstructured.Unstructured) ([]configv1alpha1.DependentObjectReference, error) {
+	for _, path := range podTemplatePaths {
+		templateMap, found, err := unstructured.NestedMap(object.Object, path...)
+		if err != nil || !found {
+			continue
+		}
+
+		podTemplate := &corev1.PodTemplateSpec{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(templateMap, podTemplate); err != nil {
+			return nil, fmt.Errorf("failed to convert PodTemplateSpec from field path %v: %v", path, err)
+		}
+
+		podObj, err := GetPodFromTemplate(podTemplate, object, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return getDependenciesFromPodTemplate(cl, podObj)
+	}
+
+	return nil, nil
+}
+
 func getDependenciesFromPodTemplate(cl client.Client, podObj *corev1.Pod) ([]configv1alpha1.DependentObjectReference, error) {
 	dependentConfigMaps := getConfigMapNames(podObj)
 	dependentSecrets := getSecretNames(podObj)
+	dependentSecrets = dependentSecrets.Union(getImagePullSecretNames(podObj)).Union(getProjectedAndCSISecretNames(podObj))
 	var dependentObjectRefs []configv1alpha1.DependentObjectReference
 	for cm := range dependentConfigMaps {
 		dependentObjectRefs = append(dependentObjectRefs, configv1alpha1.DependentObjectReference{
@@ -117,6 +229,24 @@ func getDependenciesFromPodTemplate(cl client.Client, podObj *corev1.Pod) ([]con
 		})
 	}
 
+	for sa := range getServiceAccountNames(podObj) {
+		dependentObjectRefs = append(dependentObjectRefs, configv1alpha1.DependentObjectReference{
+			APIVersion: "v1",
+			Kind:       "ServiceAccount",
+			Namespace:  podObj.Namespace,
+			Name:       sa,
+		})
+	}
+
+	for pvc := range getPersistentVolumeClaimNames(podObj) {
+		dependentObjectRefs = append(dependentObjectRefs, configv1alpha1.DependentObjectReference{
+			APIVersion: "v1",
+			Kind:       "PersistentVolumeClaim",
+			Namespace:  podObj.Namespace,
+			Name:       pvc,
+		})
+	}
+
 	dependentServices, err := getServiceDependencies(cl, podObj)
 	if err != nil {
 		return nil, err
@@ -126,6 +256,58 @@ func getDependenciesFromPodTemplate(cl client.Client, podObj *corev1.Pod) ([]con
 	return dependentObjectRefs, nil
 }
 
+// getServiceAccountNames collects the ServiceAccount the Pod runs as, if explicitly set.
+func getServiceAccountNames(podObj *corev1.Pod) sets.String {
+	dependentServiceAccounts := sets.String{}
+	if podObj.Spec.ServiceAccountName != "" {
+		dependentServiceAccounts.Insert(podObj.Spec.ServiceAccountName)
+	}
+	return dependentServiceAccounts
+}
+
+// getPersistentVolumeClaimNames collects PersistentVolumeClaims referenced by the Pod's volumes.
+func getPersistentVolumeClaimNames(podObj *corev1.Pod) sets.String {
+	dependentPVCs := sets.String{}
+	for _, volume := range podObj.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			dependentPVCs.Insert(volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+	return dependentPVCs
+}
+
+// getImagePullSecretNames collects Secrets referenced by the Pod's imagePullSecrets.
+func getImagePullSecretNames(podObj *corev1.Pod) sets.String {
+	dependentSecrets := sets.String{}
+	for _, secretRef := range podObj.Spec.ImagePullSecrets {
+		dependentSecrets.Insert(secretRef.Name)
+	}
+	return dependentSecrets
+}
+
+// getProjectedAndCSISecretNames collects Secrets referenced indirectly through a volume's
+// projected sources (e.g. downwardAPI-style secret projections) or a CSI volume's node
+// publish secret, neither of which is covered by the plain Secret-volume extractor.
+func getProjectedAndCSISecretNames(podObj *corev1.Pod) sets.String {
+	dependentSecrets := sets.String{}
+	for _, volume := range podObj.Spec.Volumes {
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil {
+					dependentSecrets.Insert(source.Secret.Name)
+				}
+			}
+		}
+
+		if volume.CSI != nil && volume.CSI.NodePublishSecretRef != nil {
+			dependentSecrets.Insert(volume.CSI.NodePublishSecretRef.Name)
+		}
+	}
+	return dependentSecrets
+}
+
+var gatewayHTTPRouteGK = schema.GroupKind{Group: "gateway.networking.k8s.io", Kind: "HTTPRoute"}
+
 func getServiceDependencies(cl client.Client, podObj *corev1.Pod) ([]configv1alpha1.DependentObjectReference, error) {
 	serviceList := &corev1.ServiceList{}
 	err := cl.List(context.TODO(), serviceList, &client.ListOptions{Namespace: podObj.GetNamespace()})
@@ -144,9 +326,184 @@ func getServiceDependencies(cl client.Client, podObj *corev1.Pod) ([]configv1alp
 		})
 	}
 
+	routingRefs, err := getServiceRoutingDependencies(cl, podObj.GetNamespace(), dependentServices)
+	if err != nil {
+		return nil, err
+	}
+	dependentObjectRef = append(dependentObjectRef, routingRefs...)
+
 	return dependentObjectRef, nil
 }
 
+// getServiceRoutingDependencies finds the Ingresses, and HTTPRoutes when the Gateway API
+// CRDs are installed on the member cluster, that front any of dependentServices, plus any
+// TLS Secret they reference. Workloads commonly break after propagation not because the
+// Service is missing but because nothing routes traffic to it on the member cluster.
+func getServiceRoutingDependencies(cl client.Client, namespace string, dependentServices sets.String) ([]configv1alpha1.DependentObjectReference, error) {
+	if dependentServices.Len() == 0 {
+		return nil, nil
+	}
+
+	var dependentObjectRefs []configv1alpha1.DependentObjectReference
+
+	ingressRefs, err := getIngressDependencies(cl, namespace, dependentServices)
+	if err != nil {
+		return nil, err
+	}
+	dependentObjectRefs = append(dependentObjectRefs, ingressRefs...)
+
+	// Don't pin a version: Gateway API clusters commonly still serve HTTPRoute at v1beta1
+	// alongside or instead of v1. Ask the RESTMapper for whatever version is actually
+	// installed rather than asserting v1 and silently skipping HTTPRoute lookup otherwise.
+	mapping, err := cl.RESTMapper().RESTMapping(gatewayHTTPRouteGK)
+	if err != nil {
+		// Gateway API CRDs are not installed on this member cluster; skip HTTPRoute lookup.
+		return dependentObjectRefs, nil
+	}
+
+	httpRouteRefs, err := getHTTPRouteDependencies(cl, namespace, mapping.GroupVersionKind, dependentServices)
+	if err != nil {
+		return nil, err
+	}
+	dependentObjectRefs = append(dependentObjectRefs, httpRouteRefs...)
+
+	return dependentObjectRefs, nil
+}
+
+func getIngressDependencies(cl client.Client, namespace string, dependentServices sets.String) ([]configv1alpha1.DependentObjectReference, error) {
+	ingressList := &networkingv1.IngressList{}
+	if err := cl.List(context.TODO(), ingressList, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	var dependentObjectRefs []configv1alpha1.DependentObjectReference
+	dependentSecrets := sets.String{}
+	for i := range ingressList.Items {
+		ingress := ingressList.Items[i]
+		if !ingressReferencesServices(&ingress, dependentServices) {
+			continue
+		}
+
+		dependentObjectRefs = append(dependentObjectRefs, configv1alpha1.DependentObjectReference{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       "Ingress",
+			Namespace:  ingress.Namespace,
+			Name:       ingress.Name,
+		})
+
+		for _, tls := range ingress.Spec.TLS {
+			if tls.SecretName != "" {
+				dependentSecrets.Insert(tls.SecretName)
+			}
+		}
+	}
+
+	for secret := range dependentSecrets {
+		dependentObjectRefs = append(dependentObjectRefs, configv1alpha1.DependentObjectReference{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Namespace:  namespace,
+			Name:       secret,
+		})
+	}
+
+	return dependentObjectRefs, nil
+}
+
+func ingressReferencesServices(ingress *networkingv1.Ingress, dependentServices sets.String) bool {
+	if ingress.Spec.DefaultBackend != nil && ingress.Spec.DefaultBackend.Service != nil &&
+		dependentServices.Has(ingress.Spec.DefaultBackend.Service.Name) {
+		return true
+	}
+
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			if path.Backend.Service != nil && dependentServices.Has(path.Backend.Service.Name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func getHTTPRouteDependencies(cl client.Client, namespace string, httpRouteGVK schema.GroupVersionKind, dependentServices sets.String) ([]configv1alpha1.DependentObjectReference, error) {
+	httpRouteList := &unstructured.UnstructuredList{}
+	httpRouteList.SetGroupVersionKind(httpRouteGVK)
+	if err := cl.List(context.TODO(), httpRouteList, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+
+	var dependentObjectRefs []configv1alpha1.DependentObjectReference
+	for _, httpRoute := range httpRouteList.Items {
+		rules, found, err := unstructured.NestedSlice(httpRoute.Object, "spec", "rules")
+		if err != nil || !found {
+			continue
+		}
+
+		if !httpRouteReferencesServices(rules, httpRoute.GetNamespace(), dependentServices) {
+			continue
+		}
+
+		dependentObjectRefs = append(dependentObjectRefs, configv1alpha1.DependentObjectReference{
+			APIVersion: httpRoute.GetAPIVersion(),
+			Kind:       httpRoute.GetKind(),
+			Namespace:  httpRoute.GetNamespace(),
+			Name:       httpRoute.GetName(),
+		})
+	}
+
+	return dependentObjectRefs, nil
+}
+
+// httpRouteReferencesServices reports whether any backendRef in rules targets a Service in
+// dependentServices. A backendRef defaults to kind Service and to the HTTPRoute's own
+// namespace when unset (Gateway API reference semantics), so a backendRef that explicitly
+// names a different kind or a different namespace must not be matched just because its name
+// happens to coincide with one of the dependent Services.
+func httpRouteReferencesServices(rules []interface{}, routeNamespace string, dependentServices sets.String) bool {
+	for _, rule := range rules {
+		ruleMap, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		backendRefs, found, err := unstructured.NestedSlice(ruleMap, "backendRefs")
+		if err != nil || !found {
+			continue
+		}
+
+		for _, backendRef := range backendRefs {
+			backendRefMap, ok := backendRef.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if kind, found, err := unstructured.NestedString(backendRefMap, "kind"); err == nil && found && kind != "Service" {
+				continue
+			}
+
+			if namespace, found, err := unstructured.NestedString(backendRefMap, "namespace"); err == nil && found && namespace != routeNamespace {
+				continue
+			}
+
+			name, found, err := unstructured.NestedString(backendRefMap, "name")
+			if err != nil || !found {
+				continue
+			}
+
+			if dependentServices.Has(name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func getDependentServiceNames(podLabels map[string]string, serviceList []corev1.Service) sets.String {
 	dependentServices := sets.String{}
 	for _, service := range serviceList {
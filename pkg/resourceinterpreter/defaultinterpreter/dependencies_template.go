@@ -0,0 +1,114 @@
+package defaultinterpreter
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+)
+
+// compileDependencyExtractionTemplate turns a declarative configv1alpha1.DependencyExtractionTemplate
+// into a dependenciesInterpreter, so that CRDs can get dependency detection from a
+// ResourceInterpreterCustomization rather than a Lua script or a Go handler registered in
+// getAllDefaultDependenciesInterpreter. getAllDefaultDependenciesInterpreter calls this for
+// every customization that sets spec.customizations.dependencyInterpretation.template.
+func compileDependencyExtractionTemplate(template *configv1alpha1.DependencyExtractionTemplate) dependenciesInterpreter {
+	rules := make([]configv1alpha1.DependencyExtractionRule, len(template.Rules))
+	copy(rules, template.Rules)
+
+	return func(_ client.Client, object *unstructured.Unstructured) ([]configv1alpha1.DependentObjectReference, error) {
+		seen := map[configv1alpha1.DependentObjectReference]struct{}{}
+		var refs []configv1alpha1.DependentObjectReference
+
+		for _, rule := range rules {
+			names, err := evaluateFieldPath(object.Object, strings.Split(rule.Path, "."))
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate dependency rule path %q: %v", rule.Path, err)
+			}
+
+			namespace := object.GetNamespace()
+			if rule.NamespaceFrom != "" {
+				if fromNamespace, found, err := unstructured.NestedString(object.Object, strings.Split(rule.NamespaceFrom, ".")...); err == nil && found {
+					namespace = fromNamespace
+				}
+			}
+
+			for _, name := range names {
+				if name == "" {
+					continue
+				}
+
+				ref := configv1alpha1.DependentObjectReference{
+					APIVersion: rule.APIVersion,
+					Kind:       rule.Kind,
+					Namespace:  namespace,
+					Name:       name,
+				}
+				if _, ok := seen[ref]; ok {
+					continue
+				}
+				seen[ref] = struct{}{}
+				refs = append(refs, ref)
+			}
+		}
+
+		return refs, nil
+	}
+}
+
+// evaluateFieldPath walks obj along path, expanding a "field[*]" segment over every element
+// of the slice found at that field and continuing the remaining path against each element.
+// It returns the string values found at the fully-resolved leaf paths.
+func evaluateFieldPath(obj map[string]interface{}, path []string) ([]string, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+
+	field := path[0]
+	rest := path[1:]
+
+	if idx := strings.Index(field, "[*]"); idx >= 0 {
+		fieldName := field[:idx]
+		sliceVal, found, err := unstructured.NestedFieldNoCopy(obj, fieldName)
+		if err != nil || !found {
+			return nil, err
+		}
+
+		items, ok := sliceVal.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("field %q is not a slice", fieldName)
+		}
+
+		var values []string
+		for _, item := range items {
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			itemValues, err := evaluateFieldPath(itemMap, rest)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, itemValues...)
+		}
+		return values, nil
+	}
+
+	if len(rest) == 0 {
+		value, found, err := unstructured.NestedString(obj, field)
+		if err != nil || !found {
+			return nil, err
+		}
+		return []string{value}, nil
+	}
+
+	nestedMap, found, err := unstructured.NestedMap(obj, field)
+	if err != nil || !found {
+		return nil, err
+	}
+	return evaluateFieldPath(nestedMap, rest)
+}
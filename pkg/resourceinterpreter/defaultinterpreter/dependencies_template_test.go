@@ -0,0 +1,102 @@
+package defaultinterpreter
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+)
+
+func TestCompileDependencyExtractionTemplate(t *testing.T) {
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"namespace": "default",
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"volumes": []interface{}{
+						map[string]interface{}{
+							"secret": map[string]interface{}{
+								"secretName": "db-creds",
+							},
+						},
+						map[string]interface{}{
+							"secret": map[string]interface{}{
+								"secretName": "db-creds",
+							},
+						},
+						map[string]interface{}{
+							"configMap": map[string]interface{}{
+								"name": "app-config",
+							},
+						},
+					},
+				},
+			},
+		},
+	}}
+
+	template := &configv1alpha1.DependencyExtractionTemplate{
+		Rules: []configv1alpha1.DependencyExtractionRule{
+			{Path: "spec.template.spec.volumes[*].secret.secretName", Kind: "Secret", APIVersion: "v1"},
+		},
+	}
+
+	interpreter := compileDependencyExtractionTemplate(template)
+	refs, err := interpreter(nil, object)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(refs) != 1 {
+		t.Fatalf("expected deduped result of 1 ref, got %d: %+v", len(refs), refs)
+	}
+
+	want := configv1alpha1.DependentObjectReference{APIVersion: "v1", Kind: "Secret", Namespace: "default", Name: "db-creds"}
+	if refs[0] != want {
+		t.Errorf("got %+v, want %+v", refs[0], want)
+	}
+}
+
+func TestGetAllDefaultDependenciesInterpreterMergesCustomizations(t *testing.T) {
+	customization := configv1alpha1.ResourceInterpreterCustomization{
+		Spec: configv1alpha1.ResourceInterpreterCustomizationSpec{
+			Target: configv1alpha1.CustomizationTarget{APIVersion: "example.io/v1", Kind: "Widget"},
+			Customizations: configv1alpha1.CustomizationRules{
+				DependencyInterpretation: &configv1alpha1.DependencyInterpretation{
+					Template: &configv1alpha1.DependencyExtractionTemplate{
+						Rules: []configv1alpha1.DependencyExtractionRule{
+							{Path: "spec.secretName", Kind: "Secret", APIVersion: "v1"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	interpreters := getAllDefaultDependenciesInterpreter([]configv1alpha1.ResourceInterpreterCustomization{customization})
+
+	gvk := schema.GroupVersionKind{Group: "example.io", Version: "v1", Kind: "Widget"}
+	interpreter, ok := interpreters[gvk]
+	if !ok {
+		t.Fatalf("expected %s to have a compiled template interpreter registered", gvk)
+	}
+
+	object := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"namespace": "ns1"},
+		"spec":     map[string]interface{}{"secretName": "widget-secret"},
+	}}
+
+	refs, err := interpreter(nil, object)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := configv1alpha1.DependentObjectReference{APIVersion: "v1", Kind: "Secret", Namespace: "ns1", Name: "widget-secret"}
+	if len(refs) != 1 || refs[0] != want {
+		t.Errorf("got %+v, want [%+v]", refs, want)
+	}
+}
@@ -0,0 +1,188 @@
+package defaultinterpreter
+
+import (
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestGetAllDefaultDependenciesInterpreterRegistersNewKinds(t *testing.T) {
+	interpreters := getAllDefaultDependenciesInterpreter(nil)
+
+	for _, gvk := range []schema.GroupVersionKind{
+		batchv1.SchemeGroupVersion.WithKind("CronJob"),
+		appsv1.SchemeGroupVersion.WithKind("ReplicaSet"),
+		corev1.SchemeGroupVersion.WithKind("ReplicationController"),
+	} {
+		if _, ok := interpreters[gvk]; !ok {
+			t.Errorf("expected a dependenciesInterpreter to be registered for %s", gvk)
+		}
+	}
+}
+
+func TestLookupDependenciesInterpreterFallsBackToUnstructured(t *testing.T) {
+	interpreters := getAllDefaultDependenciesInterpreter(nil)
+
+	unregistered := schema.GroupVersionKind{Group: "example.io", Version: "v1", Kind: "Widget"}
+	if _, ok := interpreters[unregistered]; ok {
+		t.Fatalf("expected %s to be unregistered for this test", unregistered)
+	}
+
+	interpreter := lookupDependenciesInterpreter(interpreters, unregistered)
+	if interpreter == nil {
+		t.Fatal("expected a non-nil fallback interpreter")
+	}
+
+	deployment := schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}
+	got := lookupDependenciesInterpreter(interpreters, deployment)
+	want := interpreters[deployment]
+	if reflect.ValueOf(got).Pointer() != reflect.ValueOf(want).Pointer() {
+		t.Errorf("expected the registered interpreter to be returned for %s", deployment)
+	}
+}
+
+func TestGetServiceAccountNames(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{ServiceAccountName: "my-sa"}}
+	got := getServiceAccountNames(pod)
+	if !got.Has("my-sa") || got.Len() != 1 {
+		t.Errorf("getServiceAccountNames() = %v, want [my-sa]", got.List())
+	}
+
+	emptyPod := &corev1.Pod{}
+	if got := getServiceAccountNames(emptyPod); got.Len() != 0 {
+		t.Errorf("getServiceAccountNames() = %v, want empty", got.List())
+	}
+}
+
+func TestGetImagePullSecretNames(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "regcred"}, {Name: "regcred2"}},
+	}}
+
+	got := getImagePullSecretNames(pod)
+	if !got.HasAll("regcred", "regcred2") || got.Len() != 2 {
+		t.Errorf("getImagePullSecretNames() = %v, want [regcred regcred2]", got.List())
+	}
+}
+
+func TestGetPersistentVolumeClaimNames(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-pvc"}}},
+			{Name: "cfg", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "cfg"}}}},
+		},
+	}}
+
+	got := getPersistentVolumeClaimNames(pod)
+	if !got.Has("data-pvc") || got.Len() != 1 {
+		t.Errorf("getPersistentVolumeClaimNames() = %v, want [data-pvc]", got.List())
+	}
+}
+
+func TestGetProjectedAndCSISecretNames(t *testing.T) {
+	pod := &corev1.Pod{Spec: corev1.PodSpec{
+		Volumes: []corev1.Volume{
+			{
+				Name: "projected",
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{
+							{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "projected-secret"}}},
+							{ServiceAccountToken: &corev1.ServiceAccountTokenProjection{Audience: "api"}},
+						},
+					},
+				},
+			},
+			{
+				Name: "csi",
+				VolumeSource: corev1.VolumeSource{
+					CSI: &corev1.CSIVolumeSource{
+						Driver:               "secrets-store.csi.k8s.io",
+						NodePublishSecretRef: &corev1.LocalObjectReference{Name: "csi-secret"},
+					},
+				},
+			},
+		},
+	}}
+
+	got := getProjectedAndCSISecretNames(pod)
+	if !got.HasAll("projected-secret", "csi-secret") || got.Len() != 2 {
+		t.Errorf("getProjectedAndCSISecretNames() = %v, want [projected-secret csi-secret]", got.List())
+	}
+}
+
+func TestIngressReferencesServices(t *testing.T) {
+	dependentServices := sets.NewString("web")
+
+	matching := &networkingv1.Ingress{Spec: networkingv1.IngressSpec{
+		Rules: []networkingv1.IngressRule{{
+			IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+				Paths: []networkingv1.HTTPIngressPath{{
+					Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "web"}},
+				}},
+			}},
+		}},
+	}}
+	if !ingressReferencesServices(matching, dependentServices) {
+		t.Error("expected ingress with a matching backend service to be referenced")
+	}
+
+	nonMatching := &networkingv1.Ingress{Spec: networkingv1.IngressSpec{
+		Rules: []networkingv1.IngressRule{{
+			IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+				Paths: []networkingv1.HTTPIngressPath{{
+					Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{Name: "other"}},
+				}},
+			}},
+		}},
+	}}
+	if ingressReferencesServices(nonMatching, dependentServices) {
+		t.Error("expected ingress with no matching backend service to not be referenced")
+	}
+}
+
+func TestHTTPRouteReferencesServices(t *testing.T) {
+	dependentServices := sets.NewString("web")
+
+	rules := func(kind, namespace, name string) []interface{} {
+		backendRef := map[string]interface{}{"name": name}
+		if kind != "" {
+			backendRef["kind"] = kind
+		}
+		if namespace != "" {
+			backendRef["namespace"] = namespace
+		}
+		return []interface{}{
+			map[string]interface{}{"backendRefs": []interface{}{backendRef}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		kind      string
+		namespace string
+		refName   string
+		want      bool
+	}{
+		{name: "default kind and namespace match", refName: "web", want: true},
+		{name: "explicit Service kind, same namespace", kind: "Service", namespace: "ns1", refName: "web", want: true},
+		{name: "non-Service kind is ignored", kind: "ExternalName", refName: "web", want: false},
+		{name: "cross-namespace backendRef is ignored", namespace: "other-ns", refName: "web", want: false},
+		{name: "name mismatch", refName: "other", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := httpRouteReferencesServices(rules(tt.kind, tt.namespace, tt.refName), "ns1", dependentServices)
+			if got != tt.want {
+				t.Errorf("httpRouteReferencesServices() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
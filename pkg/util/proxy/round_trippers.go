@@ -1,39 +1,107 @@
 package proxy
 
 import (
+	"context"
 	"net/http"
+	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"k8s.io/client-go/transport"
 )
 
+// proxyHeaderCacheTTL bounds how long a dynamically resolved set of proxy headers is
+// reused before the provider is asked to refresh them, e.g. after a short-lived token
+// rotates.
+const proxyHeaderCacheTTL = 30 * time.Second
+
 type ProxyHeaderRoundTripper struct {
-	proxyHeaders http.Header
 	roundTripper http.RoundTripper
 }
 
 // NewProxyHeaderRoundTripperWrapperConstructor returns a RoundTripper wrapper that's usable within restConfig.WrapTransport.
 func NewProxyHeaderRoundTripperWrapperConstructor(wt transport.WrapperFunc, headers map[string]string) transport.WrapperFunc {
+	proxyHeaders := ParseProxyHeaders(headers)
+	return NewDynamicProxyHeaderRoundTripperWrapperConstructor(wt, func(_ *http.Request) (http.Header, error) {
+		return proxyHeaders, nil
+	})
+}
+
+// NewDynamicProxyHeaderRoundTripperWrapperConstructor returns a RoundTripper wrapper that's
+// usable within restConfig.WrapTransport, resolving proxy headers via headerProvider instead
+// of freezing them at construction time. This allows callers talking to proxies that require
+// short-lived, rotating credentials (e.g. cluster registration flows) to keep the proxy
+// headers current.
+//
+// The resolved headers are only ever attached to the proxy CONNECT handshake, via
+// http.Transport.GetProxyConnectHeader, never to the outgoing request itself: if the wrapped
+// RoundTripper isn't a raw *http.Transport that terminates the header at the handshake, there
+// is no race-free way to confine the header to the proxy, so it is left untouched rather than
+// risking a credential leaking to whatever server the request targets. Resolved headers are
+// cached for proxyHeaderCacheTTL to avoid invoking headerProvider on every proxy connection.
+func NewDynamicProxyHeaderRoundTripperWrapperConstructor(wt transport.WrapperFunc, headerProvider func(req *http.Request) (http.Header, error)) transport.WrapperFunc {
 	return func(rt http.RoundTripper) http.RoundTripper {
 		if wt != nil {
 			rt = wt(rt)
 		}
-		return &ProxyHeaderRoundTripper{
-			proxyHeaders: ParseProxyHeaders(headers),
-			roundTripper: rt,
+
+		if tr, ok := rt.(*http.Transport); ok {
+			resolver := newCachedProxyHeaderResolver(headerProvider)
+			tr.GetProxyConnectHeader = func(_ context.Context, _ *url.URL, _ string) (http.Header, error) {
+				return resolver.resolve()
+			}
 		}
+
+		return &ProxyHeaderRoundTripper{roundTripper: rt}
 	}
 }
 
 // RoundTrip implements the http.RoundTripper interface
 func (r *ProxyHeaderRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
-	if tr, ok := r.roundTripper.(*http.Transport); ok {
-		tr.ProxyConnectHeader = r.proxyHeaders
-		return tr.RoundTrip(req)
-	}
 	return r.roundTripper.RoundTrip(req)
 }
 
+// cachedProxyHeaderResolver resolves proxy headers via a provider callback, reusing the last
+// resolved value for proxyHeaderCacheTTL instead of invoking the provider on every proxy
+// connection.
+type cachedProxyHeaderResolver struct {
+	headerProvider func(req *http.Request) (http.Header, error)
+
+	mu         sync.Mutex
+	headers    http.Header
+	err        error
+	resolvedAt time.Time
+}
+
+func newCachedProxyHeaderResolver(headerProvider func(req *http.Request) (http.Header, error)) *cachedProxyHeaderResolver {
+	return &cachedProxyHeaderResolver{headerProvider: headerProvider}
+}
+
+func (c *cachedProxyHeaderResolver) resolve() (http.Header, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Since(c.resolvedAt) < proxyHeaderCacheTTL {
+		return c.headers, c.err
+	}
+
+	// GetProxyConnectHeader is called per proxy connection, not per request, so there is no
+	// *http.Request to hand to headerProvider here.
+	headers, err := c.headerProvider(nil)
+	if err != nil {
+		// Don't cache a transient failure: a provider backed by a short-lived token endpoint
+		// can fail one call and succeed the next, and caching the error would otherwise poison
+		// every proxy CONNECT for the rest of the TTL instead of retrying on the next attempt.
+		return c.headers, err
+	}
+
+	c.headers = headers
+	c.err = nil
+	c.resolvedAt = time.Now()
+	return headers, nil
+}
+
 // ParseProxyHeaders will parse headers to send to proxies from given map.
 func ParseProxyHeaders(headers map[string]string) http.Header {
 	if len(headers) == 0 {
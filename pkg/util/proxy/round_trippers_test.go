@@ -0,0 +1,119 @@
+package proxy
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestParseProxyHeaders(t *testing.T) {
+	got := ParseProxyHeaders(map[string]string{"Proxy-Authorization": "Basic abc,def"})
+	want := http.Header{"Proxy-Authorization": {"Basic abc", "def"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseProxyHeaders() = %v, want %v", got, want)
+	}
+
+	if got := ParseProxyHeaders(nil); got != nil {
+		t.Errorf("ParseProxyHeaders(nil) = %v, want nil", got)
+	}
+}
+
+func TestNewProxyHeaderRoundTripperWrapperConstructorSetsGetProxyConnectHeader(t *testing.T) {
+	wrap := NewProxyHeaderRoundTripperWrapperConstructor(nil, map[string]string{"Proxy-Authorization": "Basic abc"})
+	tr := &http.Transport{}
+	wrapped := wrap(tr)
+
+	if tr.GetProxyConnectHeader == nil {
+		t.Fatal("expected GetProxyConnectHeader to be set on the wrapped *http.Transport")
+	}
+
+	headers, err := tr.GetProxyConnectHeader(nil, nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if headers.Get("Proxy-Authorization") != "Basic abc" {
+		t.Errorf("got %v, want Proxy-Authorization: Basic abc", headers)
+	}
+
+	if _, ok := wrapped.(*ProxyHeaderRoundTripper); !ok {
+		t.Errorf("expected wrapped round tripper to be a *ProxyHeaderRoundTripper, got %T", wrapped)
+	}
+}
+
+func TestNewDynamicProxyHeaderRoundTripperWrapperConstructorCachesResolvedHeaders(t *testing.T) {
+	calls := 0
+	wrap := NewDynamicProxyHeaderRoundTripperWrapperConstructor(nil, func(_ *http.Request) (http.Header, error) {
+		calls++
+		return http.Header{"Proxy-Authorization": {"Bearer token"}}, nil
+	})
+
+	tr := &http.Transport{}
+	wrap(tr)
+
+	for i := 0; i < 3; i++ {
+		if _, err := tr.GetProxyConnectHeader(nil, nil, ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("expected headerProvider to be called once due to TTL caching, got %d calls", calls)
+	}
+}
+
+func TestNewDynamicProxyHeaderRoundTripperWrapperConstructorLeavesNonTransportUntouched(t *testing.T) {
+	base := &stubRoundTripper{}
+	wrap := NewDynamicProxyHeaderRoundTripperWrapperConstructor(nil, func(_ *http.Request) (http.Header, error) {
+		return http.Header{"Proxy-Authorization": {"Bearer token"}}, nil
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	wrapped := wrap(base)
+	if _, err := wrapped.RoundTrip(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if base.lastReq.Header.Get("Proxy-Authorization") != "" {
+		t.Errorf("proxy header leaked onto the outgoing request: %v", base.lastReq.Header)
+	}
+}
+
+func TestNewDynamicProxyHeaderRoundTripperWrapperConstructorRetriesAfterTransientError(t *testing.T) {
+	calls := 0
+	wantErr := errors.New("token endpoint unavailable")
+	wrap := NewDynamicProxyHeaderRoundTripperWrapperConstructor(nil, func(_ *http.Request) (http.Header, error) {
+		calls++
+		if calls == 1 {
+			return nil, wantErr
+		}
+		return http.Header{"Proxy-Authorization": {"Bearer token"}}, nil
+	})
+
+	tr := &http.Transport{}
+	wrap(tr)
+
+	if _, err := tr.GetProxyConnectHeader(nil, nil, ""); err != wantErr {
+		t.Fatalf("GetProxyConnectHeader() error = %v, want %v", err, wantErr)
+	}
+
+	headers, err := tr.GetProxyConnectHeader(nil, nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error on retry: %v", err)
+	}
+	if headers.Get("Proxy-Authorization") != "Bearer token" {
+		t.Errorf("got %v, want Proxy-Authorization: Bearer token", headers)
+	}
+	if calls != 2 {
+		t.Errorf("expected the failed resolution to not be cached, got %d calls", calls)
+	}
+}
+
+type stubRoundTripper struct {
+	lastReq *http.Request
+}
+
+func (s *stubRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	s.lastReq = req
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}